@@ -0,0 +1,111 @@
+package main
+
+import (
+    "encoding/json"
+    "fmt"
+    "os"
+    "strings"
+
+    "github.com/naz-hage/ntools/pkg/appsmanifest"
+    "github.com/spf13/cobra"
+)
+
+// newAddCmd wires up the add subcommand, which merges the App(s) described
+// by a single manifest file into --output, replacing any existing entry
+// with the same Name.
+func newAddCmd() *cobra.Command {
+    var output string
+
+    cmd := &cobra.Command{
+        Use:   "add <manifest.json>",
+        Short: "Add or replace an app entry in apps.json from a manifest file",
+        Args:  cobra.ExactArgs(1),
+        RunE: func(cmd *cobra.Command, args []string) error {
+            return runAdd(args[0], output)
+        },
+    }
+
+    cmd.Flags().StringVar(&output, "output", "apps.json", "path to the combined manifest to update")
+
+    return cmd
+}
+
+func runAdd(manifestPath, output string) error {
+    manifestContent, err := os.ReadFile(manifestPath)
+    if err != nil {
+        return fmt.Errorf("error reading %s: %w", manifestPath, err)
+    }
+    var manifest appsmanifest.FileContent
+    if err := json.Unmarshal(manifestContent, &manifest); err != nil {
+        return fmt.Errorf("error parsing %s: %w", manifestPath, err)
+    }
+    if len(manifest.NbuildAppList) == 0 {
+        return fmt.Errorf("%s has no NbuildAppList entries", manifestPath)
+    }
+
+    combined, err := readCombined(output)
+    if err != nil {
+        return err
+    }
+
+    for _, app := range manifest.NbuildAppList {
+        combined.NbuildAppList = removeByName(combined.NbuildAppList, app.Name)
+        combined.NbuildAppList = append(combined.NbuildAppList, app)
+        fmt.Printf("Added %s\n", app.Name)
+    }
+
+    return writeCombined(output, combined)
+}
+
+func removeByName(apps []appsmanifest.App, name string) []appsmanifest.App {
+    var result []appsmanifest.App
+    for _, app := range apps {
+        if strings.EqualFold(app.Name, name) {
+            continue
+        }
+        result = append(result, app)
+    }
+    return result
+}
+
+func readCombined(path string) (appsmanifest.CombinedJSON, error) {
+    combined := appsmanifest.CombinedJSON{SchemaVersion: appsmanifest.CurrentAppsSchemaVersion}
+
+    content, err := os.ReadFile(path)
+    if os.IsNotExist(err) {
+        return combined, nil
+    }
+    if err != nil {
+        return combined, fmt.Errorf("error reading %s: %w", path, err)
+    }
+    if err := json.Unmarshal(content, &combined); err != nil {
+        return combined, fmt.Errorf("error parsing %s: %w", path, err)
+    }
+    return combined, nil
+}
+
+func writeCombined(path string, combined appsmanifest.CombinedJSON) error {
+    apps, _, err := appsmanifest.MergeApps(sourceEach(combined.NbuildAppList, path), "last-wins")
+    if err != nil {
+        return fmt.Errorf("error sorting %s: %w", path, err)
+    }
+    combined.NbuildAppList = apps
+    combined.SchemaVersion = appsmanifest.CurrentAppsSchemaVersion
+
+    content, err := json.MarshalIndent(combined, "", "  ")
+    if err != nil {
+        return fmt.Errorf("error marshaling %s: %w", path, err)
+    }
+    if err := os.WriteFile(path, content, 0644); err != nil {
+        return fmt.Errorf("error writing %s: %w", path, err)
+    }
+    return nil
+}
+
+func sourceEach(apps []appsmanifest.App, source string) []appsmanifest.SourcedApp {
+    entries := make([]appsmanifest.SourcedApp, len(apps))
+    for i, app := range apps {
+        entries[i] = appsmanifest.SourcedApp{App: app, Source: source}
+    }
+    return entries
+}
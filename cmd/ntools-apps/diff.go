@@ -0,0 +1,75 @@
+package main
+
+import (
+    "fmt"
+    "sort"
+
+    "github.com/naz-hage/ntools/pkg/appsmanifest"
+    "github.com/spf13/cobra"
+)
+
+// newDiffCmd wires up the diff subcommand, which reports apps added,
+// removed, or changed in version between two apps.json files.
+func newDiffCmd() *cobra.Command {
+    cmd := &cobra.Command{
+        Use:   "diff <old-apps.json> <new-apps.json>",
+        Short: "Show apps added, removed, or version-changed between two manifests",
+        Args:  cobra.ExactArgs(2),
+        RunE: func(cmd *cobra.Command, args []string) error {
+            return runDiff(args[0], args[1])
+        },
+    }
+
+    return cmd
+}
+
+func runDiff(oldPath, newPath string) error {
+    oldCombined, err := readCombined(oldPath)
+    if err != nil {
+        return err
+    }
+    newCombined, err := readCombined(newPath)
+    if err != nil {
+        return err
+    }
+
+    oldByName := map[string]appsmanifest.App{}
+    for _, app := range oldCombined.NbuildAppList {
+        oldByName[app.Name] = app
+    }
+    newByName := map[string]appsmanifest.App{}
+    for _, app := range newCombined.NbuildAppList {
+        newByName[app.Name] = app
+    }
+
+    newNames := make([]string, 0, len(newByName))
+    for name := range newByName {
+        newNames = append(newNames, name)
+    }
+    sort.Strings(newNames)
+
+    for _, name := range newNames {
+        newApp := newByName[name]
+        oldApp, existed := oldByName[name]
+        switch {
+        case !existed:
+            fmt.Printf("+ %s %s\n", name, newApp.Version)
+        case oldApp.Version != newApp.Version:
+            fmt.Printf("~ %s %s -> %s\n", name, oldApp.Version, newApp.Version)
+        }
+    }
+
+    oldNames := make([]string, 0, len(oldByName))
+    for name := range oldByName {
+        oldNames = append(oldNames, name)
+    }
+    sort.Strings(oldNames)
+
+    for _, name := range oldNames {
+        if _, stillPresent := newByName[name]; !stillPresent {
+            fmt.Printf("- %s\n", name)
+        }
+    }
+
+    return nil
+}
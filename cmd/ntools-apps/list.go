@@ -0,0 +1,37 @@
+package main
+
+import (
+    "fmt"
+
+    "github.com/spf13/cobra"
+)
+
+// newListCmd wires up the list subcommand, which prints the Name and
+// Version of every app currently in --output.
+func newListCmd() *cobra.Command {
+    var output string
+
+    cmd := &cobra.Command{
+        Use:   "list",
+        Short: "List the apps currently in apps.json",
+        RunE: func(cmd *cobra.Command, args []string) error {
+            return runList(output)
+        },
+    }
+
+    cmd.Flags().StringVar(&output, "output", "apps.json", "path to the combined manifest to read")
+
+    return cmd
+}
+
+func runList(output string) error {
+    combined, err := readCombined(output)
+    if err != nil {
+        return err
+    }
+
+    for _, app := range combined.NbuildAppList {
+        fmt.Printf("%s\t%s\n", app.Name, app.Version)
+    }
+    return nil
+}
@@ -0,0 +1,36 @@
+// Command ntools-apps builds and manages the apps.json manifest consumed by
+// nbuild installers, by merging per-app manifest shards into one file.
+package main
+
+import (
+    "fmt"
+    "os"
+
+    "github.com/spf13/cobra"
+)
+
+func main() {
+    if err := newRootCmd().Execute(); err != nil {
+        fmt.Fprintln(os.Stderr, err)
+        os.Exit(1)
+    }
+}
+
+func newRootCmd() *cobra.Command {
+    root := &cobra.Command{
+        Use:   "ntools-apps",
+        Short: "Build and manage the ntools apps.json manifest",
+    }
+
+    root.AddCommand(
+        newMergeCmd(),
+        newValidateCmd(),
+        newAddCmd(),
+        newRemoveCmd(),
+        newListCmd(),
+        newDiffCmd(),
+        newPackageCmd(),
+    )
+
+    return root
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+    "os"
+
+    "github.com/naz-hage/ntools/pkg/appsmanifest"
+    "github.com/spf13/cobra"
+)
+
+// newMergeCmd wires up the merge subcommand, which is the tool's original
+// one-shot behavior: scan --dir for manifest shards, migrate, merge, hash,
+// and write the combined apps.json to --output.
+func newMergeCmd() *cobra.Command {
+    var dir, output, onConflict string
+    var parallel int
+
+    cmd := &cobra.Command{
+        Use:   "merge",
+        Short: "Combine manifest shards in --dir into a single apps.json",
+        RunE: func(cmd *cobra.Command, args []string) error {
+            return runMerge(cmd.Context(), dir, output, onConflict, parallel)
+        },
+    }
+
+    cmd.Flags().StringVar(&dir, "dir", ".", "directory to scan for manifest shards")
+    cmd.Flags().StringVar(&output, "output", "apps.json", "path to write the combined manifest to")
+    cmd.Flags().StringVar(&onConflict, "on-conflict", "error", "how to resolve duplicate app names: error, first-wins, last-wins, highest-version")
+    cmd.Flags().IntVar(&parallel, "parallel", 8, "number of concurrent downloads when computing missing StoredHash values")
+
+    return cmd
+}
+
+func runMerge(ctx context.Context, dir, output, onConflict string, parallel int) error {
+    files, err := appsmanifest.GetJSONFiles(dir)
+    if err != nil {
+        return fmt.Errorf("error scanning %s: %w", dir, err)
+    }
+    fmt.Printf("%d JSON files found (excluding apps.json)\n", len(files))
+
+    var entries []appsmanifest.SourcedApp
+    for _, file := range files {
+        if err := appsmanifest.MigrateFile(file); err != nil {
+            fmt.Printf("Error migrating file %s: %v\n", file, err)
+            continue
+        }
+
+        content, err := os.ReadFile(file)
+        if err != nil {
+            fmt.Printf("Error reading file %s: %v\n", file, err)
+            continue
+        }
+
+        var fileContent appsmanifest.FileContent
+        if err := json.Unmarshal(content, &fileContent); err != nil {
+            fmt.Printf("Error parsing JSON file %s: %v\n", file, err)
+            continue
+        }
+
+        for _, app := range fileContent.NbuildAppList {
+            entries = append(entries, appsmanifest.SourcedApp{App: app, Source: file})
+        }
+    }
+
+    merged, conflicts, err := appsmanifest.MergeApps(entries, onConflict)
+    if err != nil {
+        return fmt.Errorf("error merging apps: %w", err)
+    }
+    if err := writeConflictReport(conflicts); err != nil {
+        fmt.Printf("Error reporting conflicts: %v\n", err)
+    }
+
+    for _, hashErr := range appsmanifest.PopulateStoredHashes(ctx, merged, parallel) {
+        fmt.Printf("Error computing hash: %v\n", hashErr)
+    }
+
+    combined := appsmanifest.CombinedJSON{
+        SchemaVersion: appsmanifest.CurrentAppsSchemaVersion,
+        NbuildAppList: merged,
+    }
+
+    combinedContent, err := json.MarshalIndent(combined, "", "  ")
+    if err != nil {
+        return fmt.Errorf("error marshaling combined JSON: %w", err)
+    }
+    if err := os.WriteFile(output, combinedContent, 0644); err != nil {
+        return fmt.Errorf("error writing %s: %w", output, err)
+    }
+
+    fmt.Printf("Combined JSON written to %s\n", output)
+    return nil
+}
+
+// writeConflictReport emits conflicts as JSON on stderr so CI jobs can parse
+// it to gate merges. A nil or empty conflicts is written as an empty array.
+func writeConflictReport(conflicts []appsmanifest.ConflictReport) error {
+    if conflicts == nil {
+        conflicts = []appsmanifest.ConflictReport{}
+    }
+    encoded, err := json.MarshalIndent(conflicts, "", "  ")
+    if err != nil {
+        return fmt.Errorf("error marshaling conflict report: %w", err)
+    }
+    fmt.Fprintln(os.Stderr, string(encoded))
+    return nil
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+    "fmt"
+    "path/filepath"
+
+    "github.com/naz-hage/ntools/pkg/appsmanifest/emit"
+    "github.com/spf13/cobra"
+)
+
+// newPackageCmd wires up the package subcommand, which consumes the
+// combined apps.json and emits installer metadata for other ecosystems —
+// a Scoop bucket manifest per app, plus deb/rpm/apk packages via nfpm.
+func newPackageCmd() *cobra.Command {
+    var output, outDir string
+    var formats []string
+
+    cmd := &cobra.Command{
+        Use:   "package",
+        Short: "Emit installer metadata for other package ecosystems from apps.json",
+        RunE: func(cmd *cobra.Command, args []string) error {
+            return runPackage(output, outDir, formats)
+        },
+    }
+
+    cmd.Flags().StringVar(&output, "output", "apps.json", "path to the combined manifest to read")
+    cmd.Flags().StringVar(&outDir, "out-dir", "dist", "directory to write emitted packages into")
+    cmd.Flags().StringSliceVar(&formats, "format", []string{"scoop"}, "ecosystems to emit: scoop, deb, rpm, apk")
+
+    return cmd
+}
+
+func runPackage(output, outDir string, formats []string) error {
+    combined, err := readCombined(output)
+    if err != nil {
+        return err
+    }
+
+    emitters := map[string]emit.Emitter{
+        "scoop": emit.ScoopEmitter{},
+        "deb":   emit.NfpmEmitter{Format: "deb"},
+        "rpm":   emit.NfpmEmitter{Format: "rpm"},
+        "apk":   emit.NfpmEmitter{Format: "apk"},
+    }
+
+    for _, format := range formats {
+        emitter, ok := emitters[format]
+        if !ok {
+            return fmt.Errorf("unknown package format %q", format)
+        }
+
+        for _, app := range combined.NbuildAppList {
+            if err := emitter.Emit(app, filepath.Join(outDir, format)); err != nil {
+                fmt.Printf("Error packaging %s as %s: %v\n", app.Name, format, err)
+                continue
+            }
+            fmt.Printf("Packaged %s as %s\n", app.Name, format)
+        }
+    }
+
+    return nil
+}
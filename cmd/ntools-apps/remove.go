@@ -0,0 +1,45 @@
+package main
+
+import (
+    "fmt"
+
+    "github.com/spf13/cobra"
+)
+
+// newRemoveCmd wires up the remove subcommand, which drops an app entry
+// from --output by Name (case-insensitive).
+func newRemoveCmd() *cobra.Command {
+    var output string
+
+    cmd := &cobra.Command{
+        Use:   "remove <name>",
+        Short: "Remove an app entry from apps.json by name",
+        Args:  cobra.ExactArgs(1),
+        RunE: func(cmd *cobra.Command, args []string) error {
+            return runRemove(args[0], output)
+        },
+    }
+
+    cmd.Flags().StringVar(&output, "output", "apps.json", "path to the combined manifest to update")
+
+    return cmd
+}
+
+func runRemove(name, output string) error {
+    combined, err := readCombined(output)
+    if err != nil {
+        return err
+    }
+
+    before := len(combined.NbuildAppList)
+    combined.NbuildAppList = removeByName(combined.NbuildAppList, name)
+    if len(combined.NbuildAppList) == before {
+        return fmt.Errorf("no app named %q in %s", name, output)
+    }
+
+    if err := writeCombined(output, combined); err != nil {
+        return err
+    }
+    fmt.Printf("Removed %s\n", name)
+    return nil
+}
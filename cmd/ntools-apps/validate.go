@@ -0,0 +1,112 @@
+package main
+
+import (
+    "context"
+    "crypto/sha256"
+    "encoding/hex"
+    "encoding/json"
+    "fmt"
+    "io"
+    "net/http"
+    "os"
+
+    "github.com/naz-hage/ntools/pkg/appsmanifest"
+    "github.com/spf13/cobra"
+)
+
+// newValidateCmd wires up the validate subcommand, which checks that every
+// shard under --dir matches the manifest schema, that each WebDownloadFile
+// is reachable, and that any StoredHash matches the downloaded content.
+func newValidateCmd() *cobra.Command {
+    var dir string
+
+    cmd := &cobra.Command{
+        Use:   "validate",
+        Short: "Check manifest shards for schema, URL reachability, and hash correctness",
+        RunE: func(cmd *cobra.Command, args []string) error {
+            return runValidate(cmd.Context(), dir)
+        },
+    }
+
+    cmd.Flags().StringVar(&dir, "dir", ".", "directory to scan for manifest shards")
+
+    return cmd
+}
+
+func runValidate(ctx context.Context, dir string) error {
+    files, err := appsmanifest.GetJSONFiles(dir)
+    if err != nil {
+        return fmt.Errorf("error scanning %s: %w", dir, err)
+    }
+
+    var failures int
+    for _, file := range files {
+        if err := appsmanifest.ValidateSchema(file); err != nil {
+            fmt.Printf("FAIL %s: %v\n", file, err)
+            failures++
+            continue
+        }
+
+        content, err := os.ReadFile(file)
+        if err != nil {
+            fmt.Printf("FAIL %s: %v\n", file, err)
+            failures++
+            continue
+        }
+
+        var fileContent appsmanifest.FileContent
+        if err := json.Unmarshal(content, &fileContent); err != nil {
+            fmt.Printf("FAIL %s: %v\n", file, err)
+            failures++
+            continue
+        }
+
+        for _, app := range fileContent.NbuildAppList {
+            if err := validateApp(ctx, app); err != nil {
+                fmt.Printf("FAIL %s (%s): %v\n", file, app.Name, err)
+                failures++
+            }
+        }
+    }
+
+    if failures > 0 {
+        return fmt.Errorf("%d validation failure(s)", failures)
+    }
+    fmt.Printf("%d manifest shard(s) validated\n", len(files))
+    return nil
+}
+
+// validateApp checks that app's WebDownloadFile, if set, is reachable, and
+// that its StoredHash, if set, matches the downloaded content.
+func validateApp(ctx context.Context, app appsmanifest.App) error {
+    if app.WebDownloadFile == "" {
+        return nil
+    }
+
+    req, err := http.NewRequestWithContext(ctx, http.MethodGet, app.WebDownloadFile, nil)
+    if err != nil {
+        return fmt.Errorf("error building request: %w", err)
+    }
+    resp, err := http.DefaultClient.Do(req)
+    if err != nil {
+        return fmt.Errorf("unreachable: %w", err)
+    }
+    defer resp.Body.Close()
+    if resp.StatusCode != http.StatusOK {
+        return fmt.Errorf("unreachable: unexpected status %s", resp.Status)
+    }
+
+    if app.StoredHash == "" {
+        return nil
+    }
+
+    h := sha256.New()
+    if _, err := io.Copy(h, resp.Body); err != nil {
+        return fmt.Errorf("error hashing download: %w", err)
+    }
+    if sum := hex.EncodeToString(h.Sum(nil)); sum != app.StoredHash {
+        return fmt.Errorf("hash mismatch: have %s, want %s", sum, app.StoredHash)
+    }
+
+    return nil
+}
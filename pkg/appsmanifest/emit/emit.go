@@ -0,0 +1,15 @@
+// Package emit writes App entries out as installer metadata for other
+// package ecosystems, so the same apps.json source of truth can publish to
+// Windows (Scoop) and Linux package managers simultaneously.
+package emit
+
+import "github.com/naz-hage/ntools/pkg/appsmanifest"
+
+// Emitter writes one App's installer metadata into outDir in a target
+// ecosystem's format. New target ecosystems are added by implementing this
+// interface rather than touching the merge core.
+type Emitter interface {
+    // Emit writes app's metadata into outDir, returning an error if the app
+    // cannot be represented in this ecosystem or the write fails.
+    Emit(app appsmanifest.App, outDir string) error
+}
@@ -0,0 +1,77 @@
+package emit
+
+import (
+    "fmt"
+    "os"
+    "path/filepath"
+
+    "github.com/goreleaser/nfpm/v2"
+    "github.com/goreleaser/nfpm/v2/files"
+    _ "github.com/goreleaser/nfpm/v2/apk"
+    _ "github.com/goreleaser/nfpm/v2/deb"
+    _ "github.com/goreleaser/nfpm/v2/rpm"
+
+    "github.com/naz-hage/ntools/pkg/appsmanifest"
+)
+
+// NfpmEmitter writes each App as an nfpm-compatible package for one of
+// "deb", "rpm", or "apk", letting a single source of truth publish to
+// multiple Linux package managers.
+type NfpmEmitter struct {
+    // Format is the nfpm packager name: "deb", "rpm", or "apk".
+    Format string
+}
+
+// Emit builds an nfpm package for app and writes it into outDir. Apps
+// without an InstallPath are rejected with an error, since nfpm needs a
+// destination to place the installed file under.
+func (e NfpmEmitter) Emit(app appsmanifest.App, outDir string) error {
+    if app.InstallPath == "" {
+        return fmt.Errorf("%s has no InstallPath, nothing to package", app.Name)
+    }
+
+    packager, err := nfpm.Get(e.Format)
+    if err != nil {
+        return fmt.Errorf("error resolving nfpm packager %q: %w", e.Format, err)
+    }
+
+    info := &nfpm.Info{
+        Name:    app.Name,
+        Version: app.Version,
+        Overridables: nfpm.Overridables{
+            Contents: files.Contents{
+                &files.Content{
+                    Source:      app.DownloadedFile,
+                    Destination: app.InstallPath,
+                },
+            },
+        },
+    }
+    info = nfpm.WithDefaults(info)
+
+    if err := os.MkdirAll(outDir, 0755); err != nil {
+        return fmt.Errorf("error creating %s: %w", outDir, err)
+    }
+
+    // nfpm.Get only guarantees nfpm.Packager (Package/ConventionalFileName);
+    // the file extension is on the narrower PackagerWithExtension interface
+    // that deb/rpm/apk happen to implement.
+    extPackager, ok := packager.(nfpm.PackagerWithExtension)
+    if !ok {
+        return fmt.Errorf("nfpm packager %q does not expose a file extension", e.Format)
+    }
+
+    // ConventionalExtension() already includes the leading dot (".deb", ".rpm", ...).
+    path := filepath.Join(outDir, fmt.Sprintf("%s-%s%s", app.Name, app.Version, extPackager.ConventionalExtension()))
+    out, err := os.Create(path)
+    if err != nil {
+        return fmt.Errorf("error creating %s: %w", path, err)
+    }
+    defer out.Close()
+
+    if err := packager.Package(info, out); err != nil {
+        return fmt.Errorf("error packaging %s as %s: %w", app.Name, e.Format, err)
+    }
+
+    return nil
+}
@@ -0,0 +1,34 @@
+package emit
+
+import (
+    "os"
+    "path/filepath"
+    "testing"
+
+    "github.com/naz-hage/ntools/pkg/appsmanifest"
+)
+
+func TestNfpmEmitterFilenameHasSingleDot(t *testing.T) {
+    dir := t.TempDir()
+    source := filepath.Join(dir, "payload")
+    if err := os.WriteFile(source, []byte("payload"), 0644); err != nil {
+        t.Fatalf("error writing fixture: %v", err)
+    }
+
+    outDir := filepath.Join(dir, "out")
+    app := appsmanifest.App{
+        Name:           "mytool",
+        Version:        "1.2.3",
+        DownloadedFile: source,
+        InstallPath:    "/usr/local/bin/mytool",
+    }
+
+    if err := (NfpmEmitter{Format: "deb"}).Emit(app, outDir); err != nil {
+        t.Fatalf("Emit() error = %v", err)
+    }
+
+    want := filepath.Join(outDir, "mytool-1.2.3.deb")
+    if _, err := os.Stat(want); err != nil {
+        t.Errorf("expected package at %s, got error: %v", want, err)
+    }
+}
@@ -0,0 +1,60 @@
+package emit
+
+import (
+    "encoding/json"
+    "fmt"
+    "os"
+    "path/filepath"
+
+    "github.com/naz-hage/ntools/pkg/appsmanifest"
+)
+
+// ScoopEmitter writes each App as a Scoop-style bucket manifest: one JSON
+// file per app with url, hash, bin, and an installer script derived from
+// InstallCommand/InstallArgs.
+type ScoopEmitter struct{}
+
+// scoopManifest is the subset of the Scoop manifest schema ntools populates.
+type scoopManifest struct {
+    Version   string          `json:"version"`
+    URL       string          `json:"url"`
+    Hash      string          `json:"hash,omitempty"`
+    Bin       string          `json:"bin,omitempty"`
+    Installer *scoopInstaller `json:"installer,omitempty"`
+}
+
+type scoopInstaller struct {
+    Script []string `json:"script,omitempty"`
+}
+
+// Emit writes app as a Scoop bucket manifest named "<app.Name>.json" under
+// outDir.
+func (ScoopEmitter) Emit(app appsmanifest.App, outDir string) error {
+    manifest := scoopManifest{
+        Version: app.Version,
+        URL:     app.WebDownloadFile,
+        Hash:    app.StoredHash,
+        Bin:     app.AppFileName,
+    }
+    if app.InstallCommand != "" {
+        manifest.Installer = &scoopInstaller{
+            Script: []string{fmt.Sprintf("%s %s", app.InstallCommand, app.InstallArgs)},
+        }
+    }
+
+    content, err := json.MarshalIndent(manifest, "", "  ")
+    if err != nil {
+        return fmt.Errorf("error marshaling scoop manifest for %s: %w", app.Name, err)
+    }
+
+    if err := os.MkdirAll(outDir, 0755); err != nil {
+        return fmt.Errorf("error creating %s: %w", outDir, err)
+    }
+
+    path := filepath.Join(outDir, app.Name+".json")
+    if err := os.WriteFile(path, content, 0644); err != nil {
+        return fmt.Errorf("error writing %s: %w", path, err)
+    }
+
+    return nil
+}
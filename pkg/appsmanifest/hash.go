@@ -0,0 +1,128 @@
+package appsmanifest
+
+import (
+    "context"
+    "crypto/sha256"
+    "encoding/hex"
+    "fmt"
+    "io"
+    "net/http"
+    "os"
+    "path/filepath"
+    "strings"
+    "sync"
+
+    "golang.org/x/sync/errgroup"
+)
+
+// cacheDir returns the directory used to cache the SHA-256 digests of
+// downloaded files keyed by URL and Last-Modified, creating it if necessary.
+func cacheDir() (string, error) {
+    base := os.Getenv("XDG_CACHE_HOME")
+    if base == "" {
+        home, err := os.UserHomeDir()
+        if err != nil {
+            return "", fmt.Errorf("error resolving home directory: %w", err)
+        }
+        base = filepath.Join(home, ".cache")
+    }
+    dir := filepath.Join(base, "ntools")
+    if err := os.MkdirAll(dir, 0755); err != nil {
+        return "", fmt.Errorf("error creating cache directory %s: %w", dir, err)
+    }
+    return dir, nil
+}
+
+// cacheKey derives a filesystem-safe cache filename from a URL and the
+// Last-Modified value reported for it, so a changed upstream file busts the
+// cache automatically.
+func cacheKey(url, lastModified string) string {
+    sum := sha256.Sum256([]byte(url + "|" + lastModified))
+    return hex.EncodeToString(sum[:])
+}
+
+// hashDownload fetches url, returning its hex-encoded SHA-256 digest. A
+// digest cached under dir keyed by (url, Last-Modified) is reused when
+// present so repeat merge runs do not re-download unchanged files; the
+// downloaded content itself is not kept.
+func hashDownload(ctx context.Context, client *http.Client, dir, url string) (string, error) {
+    head, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+    var lastModified string
+    if err == nil {
+        if resp, err := client.Do(head); err == nil {
+            lastModified = resp.Header.Get("Last-Modified")
+            resp.Body.Close()
+        }
+    }
+
+    cachePath := filepath.Join(dir, cacheKey(url, lastModified))
+    if cached, err := os.ReadFile(cachePath); err == nil {
+        return strings.TrimSpace(string(cached)), nil
+    }
+
+    req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+    if err != nil {
+        return "", fmt.Errorf("error building request for %s: %w", url, err)
+    }
+    resp, err := client.Do(req)
+    if err != nil {
+        return "", fmt.Errorf("error downloading %s: %w", url, err)
+    }
+    defer resp.Body.Close()
+    if resp.StatusCode != http.StatusOK {
+        return "", fmt.Errorf("error downloading %s: unexpected status %s", url, resp.Status)
+    }
+
+    h := sha256.New()
+    if _, err := io.Copy(h, resp.Body); err != nil {
+        return "", fmt.Errorf("error hashing %s: %w", url, err)
+    }
+    sum := hex.EncodeToString(h.Sum(nil))
+
+    if err := os.WriteFile(cachePath, []byte(sum), 0644); err != nil {
+        fmt.Printf("Warning: could not cache hash for %s: %v\n", url, err)
+    }
+
+    return sum, nil
+}
+
+// PopulateStoredHashes fills in StoredHash for every app with a
+// WebDownloadFile and no StoredHash, fetching up to parallel URLs
+// concurrently. Failures are collected and reported but do not abort the
+// rest of the merge.
+func PopulateStoredHashes(ctx context.Context, apps []App, parallel int) []error {
+    dir, err := cacheDir()
+    if err != nil {
+        return []error{err}
+    }
+
+    client := &http.Client{}
+    group, gctx := errgroup.WithContext(ctx)
+    group.SetLimit(parallel)
+
+    var errs []error
+    var errsMu sync.Mutex
+    for i := range apps {
+        app := &apps[i]
+        if app.WebDownloadFile == "" || app.StoredHash != "" {
+            continue
+        }
+        group.Go(func() error {
+            sum, err := hashDownload(gctx, client, dir, app.WebDownloadFile)
+            if err != nil {
+                errsMu.Lock()
+                errs = append(errs, fmt.Errorf("%s: %w", app.Name, err))
+                errsMu.Unlock()
+                return nil
+            }
+            app.StoredHash = sum
+            fmt.Printf("Hashed %s (%s)\n", app.Name, app.WebDownloadFile)
+            return nil
+        })
+    }
+
+    if err := group.Wait(); err != nil {
+        errs = append(errs, err)
+    }
+    return errs
+}
@@ -0,0 +1,127 @@
+package appsmanifest
+
+import (
+    "context"
+    "fmt"
+    "net/http"
+    "net/http/httptest"
+    "sync/atomic"
+    "testing"
+)
+
+func TestPopulateStoredHashesConcurrentAndSkipsAlreadyHashed(t *testing.T) {
+    var hits int64
+    server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        atomic.AddInt64(&hits, 1)
+        fmt.Fprintf(w, "content for %s", r.URL.Path)
+    }))
+    defer server.Close()
+
+    t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+    apps := make([]App, 20)
+    for i := range apps {
+        apps[i] = App{
+            Name:            fmt.Sprintf("app-%d", i),
+            WebDownloadFile: server.URL + fmt.Sprintf("/app-%d", i),
+        }
+    }
+
+    if errs := PopulateStoredHashes(context.Background(), apps, 8); len(errs) != 0 {
+        t.Fatalf("PopulateStoredHashes() errors = %v", errs)
+    }
+
+    seen := map[string]bool{}
+    for _, app := range apps {
+        if app.StoredHash == "" {
+            t.Errorf("app %s has no StoredHash", app.Name)
+        }
+        if seen[app.StoredHash] {
+            t.Errorf("app %s has a StoredHash collision with another app", app.Name)
+        }
+        seen[app.StoredHash] = true
+    }
+
+    firstRunHits := atomic.LoadInt64(&hits)
+    if firstRunHits == 0 {
+        t.Fatal("expected the test server to be hit at least once")
+    }
+
+    // A second pass over apps already carrying a StoredHash should not
+    // re-download anything.
+    if errs := PopulateStoredHashes(context.Background(), apps, 8); len(errs) != 0 {
+        t.Fatalf("PopulateStoredHashes() second pass errors = %v", errs)
+    }
+    if got := atomic.LoadInt64(&hits); got != firstRunHits {
+        t.Errorf("PopulateStoredHashes() re-downloaded already-hashed apps: hits went from %d to %d", firstRunHits, got)
+    }
+}
+
+func TestPopulateStoredHashesCachesByURL(t *testing.T) {
+    var gets int64
+    server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        if r.Method == http.MethodGet {
+            atomic.AddInt64(&gets, 1)
+        }
+        fmt.Fprint(w, "stable content")
+    }))
+    defer server.Close()
+
+    t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+    // Two distinct App entries pointing at the same URL: StoredHash starts
+    // empty both times, so the second call can only be served from the
+    // on-disk cache, not by PopulateStoredHashes' own skip-if-set check.
+    first := []App{{Name: "app", WebDownloadFile: server.URL + "/app"}}
+    if errs := PopulateStoredHashes(context.Background(), first, 1); len(errs) != 0 {
+        t.Fatalf("PopulateStoredHashes() errors = %v", errs)
+    }
+
+    second := []App{{Name: "app-again", WebDownloadFile: server.URL + "/app"}}
+    if errs := PopulateStoredHashes(context.Background(), second, 1); len(errs) != 0 {
+        t.Fatalf("PopulateStoredHashes() errors = %v", errs)
+    }
+
+    if first[0].StoredHash != second[0].StoredHash {
+        t.Errorf("expected identical StoredHash for the same URL, got %s and %s", first[0].StoredHash, second[0].StoredHash)
+    }
+    if got := atomic.LoadInt64(&gets); got != 1 {
+        t.Errorf("expected the cached copy to be reused instead of re-downloading, server received %d GET(s)", got)
+    }
+}
+
+func TestPopulateStoredHashesPerFileFailureDoesNotAbort(t *testing.T) {
+    server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        if r.URL.Path == "/bad" {
+            http.Error(w, "boom", http.StatusInternalServerError)
+            return
+        }
+        fmt.Fprintf(w, "content for %s", r.URL.Path)
+    }))
+    defer server.Close()
+
+    t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+    apps := []App{
+        {Name: "good-1", WebDownloadFile: server.URL + "/good-1"},
+        {Name: "bad", WebDownloadFile: server.URL + "/bad"},
+        {Name: "good-2", WebDownloadFile: server.URL + "/good-2"},
+    }
+
+    errs := PopulateStoredHashes(context.Background(), apps, 8)
+    if len(errs) != 1 {
+        t.Fatalf("PopulateStoredHashes() errors = %v, want exactly one", errs)
+    }
+
+    for _, app := range apps {
+        if app.Name == "bad" {
+            if app.StoredHash != "" {
+                t.Errorf("expected no StoredHash for the failing app, got %q", app.StoredHash)
+            }
+            continue
+        }
+        if app.StoredHash == "" {
+            t.Errorf("app %s should still be hashed despite the other app's failure", app.Name)
+        }
+    }
+}
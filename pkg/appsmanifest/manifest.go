@@ -0,0 +1,84 @@
+// Package appsmanifest reads, merges, and migrates the App manifest shards
+// that ntools combines into apps.json. It is exported so other ntools
+// binaries can load and manipulate the manifest without re-declaring these
+// types inline.
+package appsmanifest
+
+import (
+    "fmt"
+    "os"
+    "path/filepath"
+)
+
+// App describes a single installable application entry as read from a
+// manifest shard or the combined apps.json.
+type App struct {
+    Name             string `json:"Name"`
+    Version          string `json:"Version"`
+    AppFileName      string `json:"AppFileName"`
+    WebDownloadFile  string `json:"WebDownloadFile"`
+    DownloadedFile   string `json:"DownloadedFile"`
+    InstallCommand   string `json:"InstallCommand"`
+    InstallArgs      string `json:"InstallArgs"`
+    InstallPath      string `json:"InstallPath"`
+    UninstallCommand string `json:"UninstallCommand"`
+    UninstallArgs    string `json:"UninstallArgs"`
+    StoredHash       string `json:"StoredHash,omitempty"`
+}
+
+// FileContent is the shape of a single manifest shard on disk.
+type FileContent struct {
+    SchemaVersion AppsSchemaVersion `json:"SchemaVersion,omitempty"`
+    Version       string            `json:"Version,omitempty"` // legacy string version, kept for backward compatibility
+    NbuildAppList []App             `json:"NbuildAppList"`
+}
+
+// CombinedJSON is the shape of the merged apps.json.
+type CombinedJSON struct {
+    SchemaVersion AppsSchemaVersion `json:"SchemaVersion"`
+    NbuildAppList []App             `json:"NbuildAppList"`
+}
+
+// GetJSONFiles returns a slice of all .json files in the specified directory,
+// excluding any files named "apps.json".
+//
+// folderPath: The path to the directory to scan for JSON files.
+//
+// Returns a slice of file paths to .json files and an error if any.
+func GetJSONFiles(folderPath string) ([]string, error) {
+    var result []string
+
+    // Check if the directory exists
+    info, err := os.Stat(folderPath)
+    if err != nil {
+        return nil, fmt.Errorf("error accessing directory: %w", err)
+    }
+    if !info.IsDir() {
+        return nil, fmt.Errorf("path is not a directory: %s", folderPath)
+    }
+
+    // Walk through the directory
+    err = filepath.Walk(folderPath, func(path string, info os.FileInfo, err error) error {
+        if err != nil {
+            return err
+        }
+
+        // Skip directories
+        if info.IsDir() {
+            return nil
+        }
+
+        // Check for .json extension and exclude apps.json
+        if filepath.Ext(path) == ".json" && filepath.Base(path) != "apps.json" {
+            result = append(result, path)
+        }
+
+        return nil
+    })
+
+    if err != nil {
+        return nil, fmt.Errorf("error walking through directory: %w", err)
+    }
+
+    return result, nil
+}
@@ -0,0 +1,119 @@
+package appsmanifest
+
+import (
+    "fmt"
+    "sort"
+    "strconv"
+    "strings"
+)
+
+// ConflictReport describes one duplicate Name encountered during a merge
+// and how it was resolved, so CI can gate on it or display it.
+type ConflictReport struct {
+    Name     string   `json:"name"`
+    Sources  []string `json:"sources"`
+    Resolved string   `json:"resolvedSource"`
+    Policy   string   `json:"policy"`
+}
+
+// SourcedApp pairs an App with the shard file it came from, so conflicts can
+// be reported with enough context to find the offending file.
+type SourcedApp struct {
+    App    App
+    Source string
+}
+
+// MergeApps combines apps from multiple shards into a single, alphabetically
+// sorted list, resolving any duplicate Name (case-insensitive) per policy.
+// policy is one of "error", "first-wins", "last-wins", or "highest-version".
+// It returns the merged list and a report of every conflict it resolved.
+func MergeApps(entries []SourcedApp, policy string) ([]App, []ConflictReport, error) {
+    byName := map[string][]SourcedApp{}
+    var order []string
+    for _, entry := range entries {
+        key := strings.ToLower(entry.App.Name)
+        if _, ok := byName[key]; !ok {
+            order = append(order, key)
+        }
+        byName[key] = append(byName[key], entry)
+    }
+
+    var merged []App
+    var reports []ConflictReport
+    for _, key := range order {
+        group := byName[key]
+        if len(group) == 1 {
+            merged = append(merged, group[0].App)
+            continue
+        }
+
+        sources := make([]string, len(group))
+        for i, e := range group {
+            sources[i] = e.Source
+        }
+
+        winner, err := resolveConflict(group, policy)
+        if err != nil {
+            return nil, nil, fmt.Errorf("error resolving conflict for %q: %w", group[0].App.Name, err)
+        }
+
+        merged = append(merged, winner.App)
+        reports = append(reports, ConflictReport{
+            Name:     group[0].App.Name,
+            Sources:  sources,
+            Resolved: winner.Source,
+            Policy:   policy,
+        })
+    }
+
+    sort.Slice(merged, func(i, j int) bool {
+        return strings.ToLower(merged[i].Name) < strings.ToLower(merged[j].Name)
+    })
+
+    return merged, reports, nil
+}
+
+// resolveConflict picks the winning entry out of a group of same-named apps
+// according to policy.
+func resolveConflict(group []SourcedApp, policy string) (SourcedApp, error) {
+    switch policy {
+    case "error":
+        return SourcedApp{}, fmt.Errorf("duplicate app name defined in multiple files")
+    case "first-wins":
+        return group[0], nil
+    case "last-wins":
+        return group[len(group)-1], nil
+    case "highest-version":
+        best := group[0]
+        for _, candidate := range group[1:] {
+            if compareSemver(candidate.App.Version, best.App.Version) > 0 {
+                best = candidate
+            }
+        }
+        return best, nil
+    default:
+        return SourcedApp{}, fmt.Errorf("unknown conflict policy %q", policy)
+    }
+}
+
+// compareSemver compares two dotted version strings numerically,
+// component by component, treating missing or non-numeric components as 0.
+// It returns a negative number, zero, or a positive number as a < b, a == b,
+// or a > b.
+func compareSemver(a, b string) int {
+    as := strings.Split(strings.TrimPrefix(a, "v"), ".")
+    bs := strings.Split(strings.TrimPrefix(b, "v"), ".")
+    for i := 0; i < len(as) || i < len(bs); i++ {
+        var av, bv int
+        if i < len(as) {
+            av, _ = strconv.Atoi(as[i])
+        }
+        if i < len(bs) {
+            bv, _ = strconv.Atoi(bs[i])
+        }
+        if av != bv {
+            return av - bv
+        }
+    }
+    return 0
+}
@@ -0,0 +1,84 @@
+package appsmanifest
+
+import "testing"
+
+func TestCompareSemver(t *testing.T) {
+    tests := []struct {
+        a, b string
+        want int
+    }{
+        {"1.2.0", "1.2.0", 0},
+        {"1.2.0", "1.10.0", -1},
+        {"1.10.0", "1.2.0", 1},
+        {"v2.0.0", "1.9.9", 1},
+        {"1.2", "1.2.0", 0},
+        {"1.2.3", "", 1},
+    }
+
+    for _, tt := range tests {
+        got := compareSemver(tt.a, tt.b)
+        switch {
+        case tt.want < 0 && got >= 0:
+            t.Errorf("compareSemver(%q, %q) = %d, want negative", tt.a, tt.b, got)
+        case tt.want > 0 && got <= 0:
+            t.Errorf("compareSemver(%q, %q) = %d, want positive", tt.a, tt.b, got)
+        case tt.want == 0 && got != 0:
+            t.Errorf("compareSemver(%q, %q) = %d, want 0", tt.a, tt.b, got)
+        }
+    }
+}
+
+func TestMergeAppsNoConflict(t *testing.T) {
+    entries := []SourcedApp{
+        {App: App{Name: "git", Version: "1.0.0"}, Source: "a.json"},
+        {App: App{Name: "curl", Version: "1.0.0"}, Source: "b.json"},
+    }
+
+    merged, conflicts, err := MergeApps(entries, "error")
+    if err != nil {
+        t.Fatalf("MergeApps() error = %v", err)
+    }
+    if len(conflicts) != 0 {
+        t.Fatalf("MergeApps() conflicts = %v, want none", conflicts)
+    }
+    if len(merged) != 2 || merged[0].Name != "curl" || merged[1].Name != "git" {
+        t.Errorf("MergeApps() = %v, want [curl, git] sorted", merged)
+    }
+}
+
+func TestMergeAppsConflictPolicies(t *testing.T) {
+    conflicting := []SourcedApp{
+        {App: App{Name: "git", Version: "1.0.0"}, Source: "a.json"},
+        {App: App{Name: "Git", Version: "2.0.0"}, Source: "b.json"},
+    }
+
+    tests := []struct {
+        policy      string
+        wantErr     bool
+        wantVersion string
+    }{
+        {"error", true, ""},
+        {"first-wins", false, "1.0.0"},
+        {"last-wins", false, "2.0.0"},
+        {"highest-version", false, "2.0.0"},
+        {"bogus-policy", true, ""},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.policy, func(t *testing.T) {
+            merged, conflicts, err := MergeApps(conflicting, tt.policy)
+            if (err != nil) != tt.wantErr {
+                t.Fatalf("MergeApps(policy=%s) error = %v, wantErr %v", tt.policy, err, tt.wantErr)
+            }
+            if tt.wantErr {
+                return
+            }
+            if len(merged) != 1 || merged[0].Version != tt.wantVersion {
+                t.Errorf("MergeApps(policy=%s) = %v, want version %s", tt.policy, merged, tt.wantVersion)
+            }
+            if len(conflicts) != 1 || len(conflicts[0].Sources) != 2 {
+                t.Errorf("MergeApps(policy=%s) conflicts = %v, want one entry with two sources", tt.policy, conflicts)
+            }
+        })
+    }
+}
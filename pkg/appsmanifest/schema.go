@@ -0,0 +1,130 @@
+package appsmanifest
+
+import (
+    "encoding/json"
+    "fmt"
+    "os"
+)
+
+// AppsSchemaVersion identifies the shape of a manifest shard or apps.json
+// file, so that older files can be migrated forward automatically.
+type AppsSchemaVersion int
+
+const (
+    // InitialAppsSchemaVersion is the original shard shape, where
+    // "Version" was a free-form string (e.g. "1.2.0") and carried no
+    // migration metadata.
+    InitialAppsSchemaVersion AppsSchemaVersion = iota + 1
+
+    // nextAppsSchemaVersion is never itself a valid schema version; it
+    // marks the slot the next schema bump should claim. Add new versions
+    // above this line, immediately before nextAppsSchemaVersion.
+    nextAppsSchemaVersion
+)
+
+// CurrentAppsSchemaVersion is the schema version written by this build.
+const CurrentAppsSchemaVersion = nextAppsSchemaVersion - 1
+
+// versionProbe is unmarshaled first so a file's schema version can be
+// determined without committing to the full App struct shape.
+type versionProbe struct {
+    SchemaVersion AppsSchemaVersion `json:"SchemaVersion"`
+}
+
+// migrations maps the schema version a file is migrating *from* to the
+// function that rewrites it one version forward. There is no entry for
+// CurrentAppsSchemaVersion, since nothing migrates away from it.
+var migrations = map[AppsSchemaVersion]func([]byte) ([]byte, error){}
+
+// detectSchemaVersion reports the schema version of data. Files written
+// before SchemaVersion existed have no such field and are treated as
+// InitialAppsSchemaVersion.
+func detectSchemaVersion(data []byte) (AppsSchemaVersion, error) {
+    var probe versionProbe
+    if err := json.Unmarshal(data, &probe); err != nil {
+        return 0, fmt.Errorf("error parsing schema version: %w", err)
+    }
+    if probe.SchemaVersion == 0 {
+        return InitialAppsSchemaVersion, nil
+    }
+    return probe.SchemaVersion, nil
+}
+
+// migrate applies registered migrations to data in sequence until it
+// reaches CurrentAppsSchemaVersion, returning the migrated bytes.
+func migrate(data []byte, from AppsSchemaVersion) ([]byte, error) {
+    version := from
+    for version < CurrentAppsSchemaVersion {
+        step, ok := migrations[version]
+        if !ok {
+            return nil, fmt.Errorf("no migration registered from schema version %d", version)
+        }
+        migrated, err := step(data)
+        if err != nil {
+            return nil, fmt.Errorf("error migrating from schema version %d: %w", version, err)
+        }
+        data = migrated
+        version++
+    }
+    return data, nil
+}
+
+// MigrateFile upgrades the manifest shard or apps.json file at path to
+// CurrentAppsSchemaVersion in place, leaving a ".bak" sibling containing the
+// pre-migration content. Files already at the current version are left
+// untouched.
+func MigrateFile(path string) error {
+    data, err := os.ReadFile(path)
+    if err != nil {
+        return fmt.Errorf("error reading %s: %w", path, err)
+    }
+
+    version, err := detectSchemaVersion(data)
+    if err != nil {
+        return fmt.Errorf("error detecting schema version of %s: %w", path, err)
+    }
+    if version > CurrentAppsSchemaVersion {
+        return fmt.Errorf("%s has schema version %d, newer than the current %d", path, version, CurrentAppsSchemaVersion)
+    }
+    if version == CurrentAppsSchemaVersion {
+        return nil
+    }
+
+    migrated, err := migrate(data, version)
+    if err != nil {
+        return fmt.Errorf("error migrating %s: %w", path, err)
+    }
+
+    if err := os.WriteFile(path+".bak", data, 0644); err != nil {
+        return fmt.Errorf("error backing up %s: %w", path, err)
+    }
+    if err := os.WriteFile(path, migrated, 0644); err != nil {
+        return fmt.Errorf("error writing migrated %s: %w", path, err)
+    }
+
+    return nil
+}
+
+// ValidateSchema reports whether the file at path is a well-formed manifest
+// at a known schema version.
+func ValidateSchema(path string) error {
+    data, err := os.ReadFile(path)
+    if err != nil {
+        return fmt.Errorf("error reading %s: %w", path, err)
+    }
+
+    version, err := detectSchemaVersion(data)
+    if err != nil {
+        return fmt.Errorf("error detecting schema version of %s: %w", path, err)
+    }
+    if version < InitialAppsSchemaVersion || version > CurrentAppsSchemaVersion {
+        return fmt.Errorf("%s has unsupported schema version %d", path, version)
+    }
+
+    var content FileContent
+    if err := json.Unmarshal(data, &content); err != nil {
+        return fmt.Errorf("%s does not match the manifest schema: %w", path, err)
+    }
+
+    return nil
+}
@@ -0,0 +1,83 @@
+package appsmanifest
+
+import (
+    "os"
+    "path/filepath"
+    "testing"
+)
+
+func TestDetectSchemaVersion(t *testing.T) {
+    tests := []struct {
+        name string
+        data string
+        want AppsSchemaVersion
+    }{
+        {"legacy file with no SchemaVersion", `{"Version":"1.2.0","NbuildAppList":[]}`, InitialAppsSchemaVersion},
+        {"file with explicit SchemaVersion", `{"SchemaVersion":1,"NbuildAppList":[]}`, 1},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got, err := detectSchemaVersion([]byte(tt.data))
+            if err != nil {
+                t.Fatalf("detectSchemaVersion() error = %v", err)
+            }
+            if got != tt.want {
+                t.Errorf("detectSchemaVersion() = %d, want %d", got, tt.want)
+            }
+        })
+    }
+}
+
+func TestMigrateFileAtCurrentVersionIsNoop(t *testing.T) {
+    path := filepath.Join(t.TempDir(), "shard.json")
+    original := []byte(`{"SchemaVersion":1,"NbuildAppList":[]}`)
+    if err := os.WriteFile(path, original, 0644); err != nil {
+        t.Fatalf("error writing fixture: %v", err)
+    }
+
+    if err := MigrateFile(path); err != nil {
+        t.Fatalf("MigrateFile() error = %v", err)
+    }
+
+    if _, err := os.Stat(path + ".bak"); !os.IsNotExist(err) {
+        t.Errorf("MigrateFile() should not back up a file already at the current schema version")
+    }
+}
+
+func TestMigrateFileRejectsNewerSchema(t *testing.T) {
+    path := filepath.Join(t.TempDir(), "shard.json")
+    if err := os.WriteFile(path, []byte(`{"SchemaVersion":99,"NbuildAppList":[]}`), 0644); err != nil {
+        t.Fatalf("error writing fixture: %v", err)
+    }
+
+    if err := MigrateFile(path); err == nil {
+        t.Error("MigrateFile() should reject a schema version newer than current")
+    }
+}
+
+func TestValidateSchema(t *testing.T) {
+    tests := []struct {
+        name    string
+        data    string
+        wantErr bool
+    }{
+        {"well-formed current shard", `{"SchemaVersion":1,"NbuildAppList":[{"Name":"git"}]}`, false},
+        {"unsupported future version", `{"SchemaVersion":99,"NbuildAppList":[]}`, true},
+        {"malformed JSON", `{"NbuildAppList":`, true},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            path := filepath.Join(t.TempDir(), "shard.json")
+            if err := os.WriteFile(path, []byte(tt.data), 0644); err != nil {
+                t.Fatalf("error writing fixture: %v", err)
+            }
+
+            err := ValidateSchema(path)
+            if (err != nil) != tt.wantErr {
+                t.Errorf("ValidateSchema() error = %v, wantErr %v", err, tt.wantErr)
+            }
+        })
+    }
+}